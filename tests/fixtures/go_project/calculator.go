@@ -1,32 +1,65 @@
 package calculator
 
-import "fmt"
+import (
+    "errors"
+    "fmt"
+
+    "go_project/expr"
+)
+
+// ErrDivideByZero is returned when a division operation would divide by zero.
+var ErrDivideByZero = errors.New("calculator: division by zero")
 
 // Add adds two numbers together
 func Add(a, b int) int {
-    return a + b
+    c := NewCalculator()
+    c.total = float64(a)
+    c.Add(float64(b))
+    return int(c.total)
 }
 
 // Subtract subtracts b from a
 func Subtract(a, b int) int {
-    return a - b
+    c := NewCalculator()
+    c.total = float64(a)
+    c.Sub(float64(b))
+    return int(c.total)
 }
 
 // Multiply multiplies two numbers
 func Multiply(a, b int) int {
-    return a * b
+    c := NewCalculator()
+    c.total = float64(a)
+    c.Mul(float64(b))
+    return int(c.total)
 }
 
-// Divide divides a by b
-func Divide(a, b float64) float64 {
+// Divide divides a by b, returning an error if b is zero.
+func Divide(a, b float64) (float64, error) {
     if b == 0 {
+        return 0, ErrDivideByZero
+    }
+    c := NewCalculator()
+    c.total = a
+    c.Div(b)
+    return c.total, nil
+}
+
+// DivideOrZero divides a by b, returning 0 on division by zero. Kept for
+// back-compat with callers that predate Divide's error return.
+func DivideOrZero(a, b float64) float64 {
+    result, err := Divide(a, b)
+    if err != nil {
         return 0
     }
-    return a / b
+    return result
 }
 
-// Calculator represents a calculator with history
+// Calculator represents a calculator with a running total and history.
 type Calculator struct {
+    total   float64
+    vars    map[string]float64
+    err     error
     History []string
 }
 
@@ -37,6 +70,28 @@ func NewCalculator() *Calculator {
     }
 }
 
+// Set binds name to value so it can be referenced as a variable, for
+// example by the expr subpackage when evaluating expressions against c.
+func (c *Calculator) Set(name string, value float64) {
+    if c.vars == nil {
+        c.vars = make(map[string]float64)
+    }
+    c.vars[name] = value
+}
+
+// Get returns the value bound to name and whether it was found.
+func (c *Calculator) Get(name string) (float64, bool) {
+    v, ok := c.vars[name]
+    return v, ok
+}
+
+// Eval parses and evaluates src as an arithmetic expression against c,
+// appending "<src> = <result>" to History on success. Variables referenced
+// in src must have been bound first with Set.
+func (c *Calculator) Eval(src string) (float64, error) {
+    return expr.NewEvaluator(c).Eval(src)
+}
+
 // AddToHistory adds an entry to the calculator history
 func (c *Calculator) AddToHistory(entry string) {
     c.History = append(c.History, entry)
@@ -53,3 +108,98 @@ func (c *Calculator) PrintHistory() {
         fmt.Println(entry)
     }
 }
+
+// Add adds n to the running total and records the operation in History. If
+// an earlier operation in the chain failed, Add is a no-op; see Err.
+func (c *Calculator) Add(n float64) *Calculator {
+    if c.err != nil {
+        return c
+    }
+    c.total += n
+    c.AddToHistory(fmt.Sprintf("+ %v = %v", n, c.total))
+    return c
+}
+
+// Sub subtracts n from the running total and records the operation in
+// History. If an earlier operation in the chain failed, Sub is a no-op; see
+// Err.
+func (c *Calculator) Sub(n float64) *Calculator {
+    if c.err != nil {
+        return c
+    }
+    c.total -= n
+    c.AddToHistory(fmt.Sprintf("- %v = %v", n, c.total))
+    return c
+}
+
+// Mul multiplies the running total by n and records the operation in
+// History. If an earlier operation in the chain failed, Mul is a no-op; see
+// Err.
+func (c *Calculator) Mul(n float64) *Calculator {
+    if c.err != nil {
+        return c
+    }
+    c.total *= n
+    c.AddToHistory(fmt.Sprintf("* %v = %v", n, c.total))
+    return c
+}
+
+// Div divides the running total by n and records the operation in History.
+// Division by zero leaves the total unchanged, records the attempt, and sets
+// a sticky error retrievable with Err; every subsequent chained operation
+// becomes a no-op until the calculator is Reset.
+func (c *Calculator) Div(n float64) *Calculator {
+    if c.err != nil {
+        return c
+    }
+    if n == 0 {
+        c.err = ErrDivideByZero
+        c.AddToHistory(fmt.Sprintf("/ %v = error: %s", n, ErrDivideByZero))
+        return c
+    }
+    c.total /= n
+    c.AddToHistory(fmt.Sprintf("/ %v = %v", n, c.total))
+    return c
+}
+
+// Result returns the current running total.
+func (c *Calculator) Result() float64 {
+    return c.total
+}
+
+// Err returns the first error encountered by a chained operation (currently
+// only divide-by-zero), or nil if none occurred since the last Reset.
+func (c *Calculator) Err() error {
+    return c.err
+}
+
+// Reset zeroes the running total, clears History, and clears any sticky
+// error set by a prior chained operation.
+func (c *Calculator) Reset() *Calculator {
+    c.total = 0
+    c.err = nil
+    c.History = make([]string, 0)
+    return c
+}
+
+// Snapshot returns a copy of the calculator's current state, suitable for
+// branching into an alternate chain of operations without affecting c.
+func (c *Calculator) Snapshot() Calculator {
+    history := make([]string, len(c.History))
+    copy(history, c.History)
+
+    var vars map[string]float64
+    if c.vars != nil {
+        vars = make(map[string]float64, len(c.vars))
+        for k, v := range c.vars {
+            vars[k] = v
+        }
+    }
+
+    return Calculator{
+        total:   c.total,
+        vars:    vars,
+        err:     c.err,
+        History: history,
+    }
+}
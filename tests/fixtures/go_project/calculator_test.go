@@ -0,0 +1,75 @@
+package calculator
+
+import "testing"
+
+func TestChain(t *testing.T) {
+    c := NewCalculator()
+    got := c.Add(2).Sub(1).Mul(4).Div(2).Result()
+    if got != 2 {
+        t.Errorf("Result() = %v, want 2", got)
+    }
+    want := []string{"+ 2 = 2", "- 1 = 1", "* 4 = 4", "/ 2 = 2"}
+    if len(c.History) != len(want) {
+        t.Fatalf("History = %v, want %v", c.History, want)
+    }
+    for i, entry := range want {
+        if c.History[i] != entry {
+            t.Errorf("History[%d] = %q, want %q", i, c.History[i], entry)
+        }
+    }
+}
+
+func TestDivByZeroSticksAndShortCircuits(t *testing.T) {
+    c := NewCalculator()
+    c.Add(2).Div(0).Mul(100)
+
+    if c.Err() != ErrDivideByZero {
+        t.Fatalf("Err() = %v, want %v", c.Err(), ErrDivideByZero)
+    }
+    if c.Result() != 2 {
+        t.Errorf("Result() = %v, want 2 (chain should stop after the error)", c.Result())
+    }
+
+    c.Reset()
+    if c.Err() != nil {
+        t.Errorf("Err() after Reset = %v, want nil", c.Err())
+    }
+}
+
+func TestSnapshotIsIndependent(t *testing.T) {
+    c := NewCalculator()
+    c.Add(5)
+    c.Set("x", 10)
+
+    snap := c.Snapshot()
+    if v, ok := snap.Get("x"); !ok || v != 10 {
+        t.Fatalf("snapshot Get(\"x\") = %v, %v, want 10, true (variables must carry over)", v, ok)
+    }
+
+    snap.Add(1)
+    snap.Set("x", 20)
+
+    if c.Result() != 5 {
+        t.Errorf("original Result() = %v, want 5 (snapshot mutation leaked back)", c.Result())
+    }
+    if v, ok := c.Get("x"); !ok || v != 10 {
+        t.Errorf("original Get(\"x\") = %v, %v, want 10, true (snapshot mutation leaked back)", v, ok)
+    }
+}
+
+func TestDivide(t *testing.T) {
+    result, err := Divide(6, 2)
+    if err != nil || result != 3 {
+        t.Errorf("Divide(6, 2) = %v, %v, want 3, nil", result, err)
+    }
+
+    if _, err := Divide(1, 0); err != ErrDivideByZero {
+        t.Errorf("Divide(1, 0) err = %v, want %v", err, ErrDivideByZero)
+    }
+}
+
+func TestDivideOrZero(t *testing.T) {
+    if got := DivideOrZero(1, 0); got != 0 {
+        t.Errorf("DivideOrZero(1, 0) = %v, want 0", got)
+    }
+}
@@ -0,0 +1,76 @@
+// Command calc evaluates arithmetic expressions using the calculator
+// package.
+//
+// Usage:
+//
+//	calc <expr>...
+//
+// The arguments are joined with spaces and evaluated as a single expression.
+// With no arguments, calc reads expressions from stdin as an interactive
+// REPL. The meta-commands "history", "clear", and "quit" are recognized in
+// addition to arithmetic expressions.
+package main
+
+import (
+    "bufio"
+    "fmt"
+    "os"
+    "strings"
+
+    "go_project"
+)
+
+func main() {
+    c := calculator.NewCalculator()
+
+    if len(os.Args) > 1 {
+        runArgs(c, os.Args[1:])
+        return
+    }
+    runREPL(c, os.Stdin, os.Stdout)
+}
+
+func runArgs(c *calculator.Calculator, exprs []string) {
+    // The shell splits an unquoted "calc 1 + 2" into separate argv elements,
+    // so they're joined back into a single expression before evaluating.
+    src := strings.Join(exprs, " ")
+    result, err := c.Eval(src)
+    if err != nil {
+        fmt.Fprintln(os.Stderr, err)
+        return
+    }
+    fmt.Println(result)
+}
+
+func runREPL(c *calculator.Calculator, in *os.File, out *os.File) {
+    scanner := bufio.NewScanner(in)
+    fmt.Fprint(out, "> ")
+    for scanner.Scan() {
+        line := strings.TrimSpace(scanner.Text())
+        switch line {
+        case "":
+            fmt.Fprint(out, "> ")
+            continue
+        case "quit":
+            return
+        case "clear":
+            c.Reset()
+            fmt.Fprint(out, "> ")
+            continue
+        case "history":
+            for _, entry := range c.GetHistory() {
+                fmt.Fprintln(out, entry)
+            }
+            fmt.Fprint(out, "> ")
+            continue
+        }
+
+        result, err := c.Eval(line)
+        if err != nil {
+            fmt.Fprintln(out, err)
+        } else {
+            fmt.Fprintln(out, result)
+        }
+        fmt.Fprint(out, "> ")
+    }
+}
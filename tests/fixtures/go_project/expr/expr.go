@@ -0,0 +1,53 @@
+// Package expr parses and evaluates infix arithmetic expressions against an
+// Env, recording each evaluated expression in its history.
+package expr
+
+import "fmt"
+
+// SyntaxError reports a parse or evaluation failure at a byte offset in the
+// original expression source.
+type SyntaxError struct {
+    Offset int
+    Msg    string
+}
+
+func (e *SyntaxError) Error() string {
+    return fmt.Sprintf("expr: %s (at offset %d)", e.Msg, e.Offset)
+}
+
+// Env supplies variable lookups and history recording for an expression
+// evaluation. *calculator.Calculator satisfies Env, but expr depends only on
+// this interface so it does not need to import the calculator package.
+type Env interface {
+    Get(name string) (float64, bool)
+    AddToHistory(entry string)
+}
+
+// Evaluator evaluates expressions against a bound Env.
+type Evaluator struct {
+    env Env
+}
+
+// NewEvaluator returns an Evaluator that records results and history onto env.
+func NewEvaluator(env Env) *Evaluator {
+    return &Evaluator{env: env}
+}
+
+// Eval parses and evaluates src, appending "<src> = <result>" to the bound
+// Env's history on success.
+func (e *Evaluator) Eval(src string) (float64, error) {
+    p := newParser(src)
+    node, err := p.parseExpr(0)
+    if err != nil {
+        return 0, err
+    }
+    if err := p.expectEOF(); err != nil {
+        return 0, err
+    }
+    result, err := node.eval(e.env)
+    if err != nil {
+        return 0, err
+    }
+    e.env.AddToHistory(fmt.Sprintf("%s = %v", src, result))
+    return result, nil
+}
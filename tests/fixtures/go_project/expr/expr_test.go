@@ -0,0 +1,100 @@
+package expr
+
+import "testing"
+
+// fakeEnv is a minimal Env for testing expr in isolation from the
+// calculator package.
+type fakeEnv struct {
+    vars    map[string]float64
+    history []string
+}
+
+func newFakeEnv() *fakeEnv {
+    return &fakeEnv{vars: make(map[string]float64)}
+}
+
+func (e *fakeEnv) Get(name string) (float64, bool) {
+    v, ok := e.vars[name]
+    return v, ok
+}
+
+func (e *fakeEnv) AddToHistory(entry string) {
+    e.history = append(e.history, entry)
+}
+
+func TestEvalPrecedenceAndAssociativity(t *testing.T) {
+    cases := []struct {
+        src  string
+        want float64
+    }{
+        {"1 + 2 * 3", 7},
+        {"(1 + 2) * 3", 9},
+        {"1 + 2 * 3 / (4 - 5)", -5},
+        {"10 - 2 - 3", 5},
+        {"2 * 3 * 4", 24},
+        {"-2 + 3", 1},
+        {"-(2 + 3) * 4", -20},
+        {"+5", 5},
+        {"3.5 + 0.5", 4},
+    }
+    for _, tc := range cases {
+        e := NewEvaluator(newFakeEnv())
+        got, err := e.Eval(tc.src)
+        if err != nil {
+            t.Errorf("Eval(%q) returned error: %v", tc.src, err)
+            continue
+        }
+        if got != tc.want {
+            t.Errorf("Eval(%q) = %v, want %v", tc.src, got, tc.want)
+        }
+    }
+}
+
+func TestEvalVariables(t *testing.T) {
+    env := newFakeEnv()
+    env.vars["x"] = 10
+    e := NewEvaluator(env)
+
+    got, err := e.Eval("x * 2 + 1")
+    if err != nil {
+        t.Fatalf("Eval returned error: %v", err)
+    }
+    if got != 21 {
+        t.Errorf("Eval(\"x * 2 + 1\") = %v, want 21", got)
+    }
+
+    want := "x * 2 + 1 = 21"
+    if len(env.history) != 1 || env.history[0] != want {
+        t.Errorf("history = %v, want [%q]", env.history, want)
+    }
+}
+
+func TestEvalErrors(t *testing.T) {
+    cases := []struct {
+        src        string
+        wantOffset int
+    }{
+        {"1 + ", 4},
+        {"(1 + 2", 0},
+        {"1 + y", 4},
+        {"1 / 0", 2},
+        {"1 2", 2},
+        {"1 $ 2", 2},
+    }
+    for _, tc := range cases {
+        e := NewEvaluator(newFakeEnv())
+        _, err := e.Eval(tc.src)
+        if err == nil {
+            t.Errorf("Eval(%q) = nil error, want a SyntaxError", tc.src)
+            continue
+        }
+        synErr, ok := err.(*SyntaxError)
+        if !ok {
+            t.Errorf("Eval(%q) error type = %T, want *SyntaxError", tc.src, err)
+            continue
+        }
+        if synErr.Offset != tc.wantOffset {
+            t.Errorf("Eval(%q) offset = %d, want %d", tc.src, synErr.Offset, tc.wantOffset)
+        }
+    }
+}
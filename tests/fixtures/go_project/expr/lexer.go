@@ -0,0 +1,98 @@
+package expr
+
+import (
+    "strconv"
+    "unicode"
+)
+
+type tokenKind int
+
+const (
+    tokEOF tokenKind = iota
+    tokNumber
+    tokIdent
+    tokPlus
+    tokMinus
+    tokStar
+    tokSlash
+    tokLParen
+    tokRParen
+)
+
+type token struct {
+    kind   tokenKind
+    text   string
+    num    float64
+    offset int
+}
+
+type lexer struct {
+    src string
+    pos int
+}
+
+func newLexer(src string) *lexer {
+    return &lexer{src: src}
+}
+
+func (l *lexer) next() (token, error) {
+    for l.pos < len(l.src) && unicode.IsSpace(rune(l.src[l.pos])) {
+        l.pos++
+    }
+    if l.pos >= len(l.src) {
+        return token{kind: tokEOF, offset: l.pos}, nil
+    }
+
+    start := l.pos
+    ch := l.src[l.pos]
+
+    switch {
+    case ch == '+':
+        l.pos++
+        return token{kind: tokPlus, text: "+", offset: start}, nil
+    case ch == '-':
+        l.pos++
+        return token{kind: tokMinus, text: "-", offset: start}, nil
+    case ch == '*':
+        l.pos++
+        return token{kind: tokStar, text: "*", offset: start}, nil
+    case ch == '/':
+        l.pos++
+        return token{kind: tokSlash, text: "/", offset: start}, nil
+    case ch == '(':
+        l.pos++
+        return token{kind: tokLParen, text: "(", offset: start}, nil
+    case ch == ')':
+        l.pos++
+        return token{kind: tokRParen, text: ")", offset: start}, nil
+    case ch >= '0' && ch <= '9' || ch == '.':
+        for l.pos < len(l.src) && (isDigit(l.src[l.pos]) || l.src[l.pos] == '.') {
+            l.pos++
+        }
+        text := l.src[start:l.pos]
+        num, err := strconv.ParseFloat(text, 64)
+        if err != nil {
+            return token{}, &SyntaxError{Offset: start, Msg: "invalid number literal " + text}
+        }
+        return token{kind: tokNumber, text: text, num: num, offset: start}, nil
+    case isIdentStart(ch):
+        for l.pos < len(l.src) && isIdentPart(l.src[l.pos]) {
+            l.pos++
+        }
+        return token{kind: tokIdent, text: l.src[start:l.pos], offset: start}, nil
+    default:
+        return token{}, &SyntaxError{Offset: start, Msg: "unexpected character " + string(ch)}
+    }
+}
+
+func isDigit(b byte) bool {
+    return b >= '0' && b <= '9'
+}
+
+func isIdentStart(b byte) bool {
+    return b == '_' || b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z'
+}
+
+func isIdentPart(b byte) bool {
+    return isIdentStart(b) || isDigit(b)
+}
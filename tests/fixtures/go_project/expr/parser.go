@@ -0,0 +1,195 @@
+package expr
+
+// node is an evaluable AST node. Parsing does not involve an Env; each node
+// evaluates against the Env passed to eval, so variable lookups see that
+// Env's bindings at evaluation time.
+type node interface {
+    eval(env Env) (float64, error)
+}
+
+type numberNode struct {
+    value float64
+}
+
+func (n *numberNode) eval(env Env) (float64, error) {
+    return n.value, nil
+}
+
+type identNode struct {
+    name   string
+    offset int
+}
+
+func (n *identNode) eval(env Env) (float64, error) {
+    v, ok := env.Get(n.name)
+    if !ok {
+        return 0, &SyntaxError{Offset: n.offset, Msg: "undefined variable " + n.name}
+    }
+    return v, nil
+}
+
+type unaryNode struct {
+    op      tokenKind
+    operand node
+}
+
+func (n *unaryNode) eval(env Env) (float64, error) {
+    v, err := n.operand.eval(env)
+    if err != nil {
+        return 0, err
+    }
+    if n.op == tokMinus {
+        return -v, nil
+    }
+    return v, nil
+}
+
+type binaryNode struct {
+    op          tokenKind
+    left, right node
+    offset      int
+}
+
+func (n *binaryNode) eval(env Env) (float64, error) {
+    l, err := n.left.eval(env)
+    if err != nil {
+        return 0, err
+    }
+    r, err := n.right.eval(env)
+    if err != nil {
+        return 0, err
+    }
+    switch n.op {
+    case tokPlus:
+        return l + r, nil
+    case tokMinus:
+        return l - r, nil
+    case tokStar:
+        return l * r, nil
+    case tokSlash:
+        if r == 0 {
+            return 0, &SyntaxError{Offset: n.offset, Msg: "division by zero"}
+        }
+        return l / r, nil
+    default:
+        return 0, &SyntaxError{Offset: n.offset, Msg: "unknown operator"}
+    }
+}
+
+// parser implements a Pratt (precedence-climbing) parser over the token
+// stream produced by lexer.
+type parser struct {
+    lex *lexer
+    tok token
+}
+
+func newParser(src string) *parser {
+    p := &parser{lex: newLexer(src)}
+    p.advance()
+    return p
+}
+
+func (p *parser) advance() error {
+    tok, err := p.lex.next()
+    if err != nil {
+        return err
+    }
+    p.tok = tok
+    return nil
+}
+
+func (p *parser) expectEOF() error {
+    if p.tok.kind != tokEOF {
+        return &SyntaxError{Offset: p.tok.offset, Msg: "unexpected trailing input " + p.tok.text}
+    }
+    return nil
+}
+
+// precedence returns the binding power of a binary operator, or -1 if k is
+// not a binary operator.
+func precedence(k tokenKind) int {
+    switch k {
+    case tokPlus, tokMinus:
+        return 1
+    case tokStar, tokSlash:
+        return 2
+    default:
+        return -1
+    }
+}
+
+// parseExpr parses an expression, consuming binary operators with binding
+// power of at least minPrec.
+func (p *parser) parseExpr(minPrec int) (node, error) {
+    left, err := p.parsePrefix()
+    if err != nil {
+        return nil, err
+    }
+
+    for {
+        prec := precedence(p.tok.kind)
+        if prec < minPrec {
+            break
+        }
+        op := p.tok.kind
+        offset := p.tok.offset
+        if err := p.advance(); err != nil {
+            return nil, err
+        }
+        right, err := p.parseExpr(prec + 1)
+        if err != nil {
+            return nil, err
+        }
+        left = &binaryNode{op: op, left: left, right: right, offset: offset}
+    }
+    return left, nil
+}
+
+func (p *parser) parsePrefix() (node, error) {
+    switch p.tok.kind {
+    case tokMinus:
+        if err := p.advance(); err != nil {
+            return nil, err
+        }
+        operand, err := p.parseExpr(precedence(tokStar))
+        if err != nil {
+            return nil, err
+        }
+        return &unaryNode{op: tokMinus, operand: operand}, nil
+    case tokPlus:
+        if err := p.advance(); err != nil {
+            return nil, err
+        }
+        return p.parseExpr(precedence(tokStar))
+    case tokNumber:
+        n := &numberNode{value: p.tok.num}
+        if err := p.advance(); err != nil {
+            return nil, err
+        }
+        return n, nil
+    case tokIdent:
+        n := &identNode{name: p.tok.text, offset: p.tok.offset}
+        if err := p.advance(); err != nil {
+            return nil, err
+        }
+        return n, nil
+    case tokLParen:
+        offset := p.tok.offset
+        if err := p.advance(); err != nil {
+            return nil, err
+        }
+        inner, err := p.parseExpr(0)
+        if err != nil {
+            return nil, err
+        }
+        if p.tok.kind != tokRParen {
+            return nil, &SyntaxError{Offset: offset, Msg: "unclosed parenthesis"}
+        }
+        if err := p.advance(); err != nil {
+            return nil, err
+        }
+        return inner, nil
+    default:
+        return nil, &SyntaxError{Offset: p.tok.offset, Msg: "unexpected token " + p.tok.text}
+    }
+}
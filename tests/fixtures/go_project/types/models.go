@@ -1,11 +1,158 @@
 package types
 
+import (
+    "fmt"
+    "math"
+)
+
+// Unit is a length (or, for angles, radian) quantity expressed in
+// millimeters. The named constants below convert the common units used
+// elsewhere in the geometry package into Unit.
+type Unit float64
+
+// MM is the base unit; all other constants are defined relative to it.
+const (
+    MM Unit = 1
+    CM Unit = 10 * MM
+    DM Unit = 100 * MM
+    IN Unit = 25.4 * MM
+    PT Unit = IN / 72
+)
+
+// String formats u in millimeters, e.g. "25.4mm".
+func (u Unit) String() string {
+    return fmt.Sprintf("%gmm", float64(u))
+}
+
+// Point is a 2D point with coordinates in Unit.
 type Point struct {
+    X Unit
+    Y Unit
+}
+
+// IntPoint is the original int-based Point, kept for callers that have not
+// migrated to the Unit-based Point.
+type IntPoint struct {
     X int
     Y int
 }
 
+// Add returns the sum of p and other.
+func (p Point) Add(other Point) Point {
+    return Point{X: p.X + other.X, Y: p.Y + other.Y}
+}
+
+// Sub returns the difference of p and other.
+func (p Point) Sub(other Point) Point {
+    return Point{X: p.X - other.X, Y: p.Y - other.Y}
+}
+
+// AddX returns a copy of p with dx added to X.
+func (p Point) AddX(dx Unit) Point {
+    return Point{X: p.X + dx, Y: p.Y}
+}
+
+// AddY returns a copy of p with dy added to Y.
+func (p Point) AddY(dy Unit) Point {
+    return Point{X: p.X, Y: p.Y + dy}
+}
+
+// Scale returns p with both coordinates multiplied by factor.
+func (p Point) Scale(factor float64) Point {
+    return Point{X: Unit(float64(p.X) * factor), Y: Unit(float64(p.Y) * factor)}
+}
+
+// Distance returns the straight-line distance between p and other.
+func (p Point) Distance(other Point) Unit {
+    dx := float64(p.X - other.X)
+    dy := float64(p.Y - other.Y)
+    return Unit(math.Hypot(dx, dy))
+}
+
+// Rotate returns p rotated by theta radians around the origin.
+func (p Point) Rotate(theta Unit) Point {
+    sin, cos := math.Sincos(float64(theta))
+    x, y := float64(p.X), float64(p.Y)
+    return Point{
+        X: Unit(x*cos - y*sin),
+        Y: Unit(x*sin + y*cos),
+    }
+}
+
+// Polar is a point expressed in polar coordinates: a radius R and an angle
+// Theta in radians.
+type Polar struct {
+    R     Unit
+    Theta Unit
+}
+
+// ToCartesian converts p to a Cartesian Point.
+func (p Polar) ToCartesian() Point {
+    sin, cos := math.Sincos(float64(p.Theta))
+    r := float64(p.R)
+    return Point{X: Unit(r * cos), Y: Unit(r * sin)}
+}
+
+// FromCartesian converts a Cartesian Point to Polar coordinates.
+func FromCartesian(p Point) Polar {
+    x, y := float64(p.X), float64(p.Y)
+    return Polar{
+        R:     Unit(math.Hypot(x, y)),
+        Theta: Unit(math.Atan2(y, x)),
+    }
+}
+
+// Path is an ordered sequence of points, e.g. the vertices of a polyline.
+type Path []Point
+
+// Length returns the total length of the path, summing the distance between
+// each consecutive pair of points.
+func (path Path) Length() Unit {
+    var total Unit
+    for i := 1; i < len(path); i++ {
+        total += path[i-1].Distance(path[i])
+    }
+    return total
+}
+
+// BoundingBox returns the minimum and maximum corners of the axis-aligned
+// box enclosing path. It returns the zero Point for both corners if path is
+// empty.
+func (path Path) BoundingBox() (min, max Point) {
+    if len(path) == 0 {
+        return Point{}, Point{}
+    }
+    min, max = path[0], path[0]
+    for _, p := range path[1:] {
+        if p.X < min.X {
+            min.X = p.X
+        }
+        if p.Y < min.Y {
+            min.Y = p.Y
+        }
+        if p.X > max.X {
+            max.X = p.X
+        }
+        if p.Y > max.Y {
+            max.Y = p.Y
+        }
+    }
+    return min, max
+}
+
+// Translate returns a copy of path with every point shifted by (dx, dy).
+func (path Path) Translate(dx, dy Unit) Path {
+    out := make(Path, len(path))
+    for i, p := range path {
+        out[i] = Point{X: p.X + dx, Y: p.Y + dy}
+    }
+    return out
+}
+
+// Calculator describes a geometric calculator capable of basic arithmetic
+// plus distance measurement between two Points.
 type Calculator interface {
     Add(a int, b int) int
     Subtract(a int, b int) int
+    Distance(a, b Point) Unit
 }
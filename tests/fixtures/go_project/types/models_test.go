@@ -0,0 +1,79 @@
+package types
+
+import (
+    "math"
+    "testing"
+)
+
+func almostEqual(a, b Unit) bool {
+    return math.Abs(float64(a-b)) < 1e-9
+}
+
+func TestUnitConstants(t *testing.T) {
+    if CM != 10*MM {
+        t.Errorf("CM = %v, want %v", CM, 10*MM)
+    }
+    if IN != 25.4*MM {
+        t.Errorf("IN = %v, want %v", IN, 25.4*MM)
+    }
+    if PT != IN/72 {
+        t.Errorf("PT = %v, want %v", PT, IN/72)
+    }
+}
+
+func TestPointDistance(t *testing.T) {
+    a := Point{X: 0, Y: 0}
+    b := Point{X: 3, Y: 4}
+    if got := a.Distance(b); !almostEqual(got, 5) {
+        t.Errorf("Distance = %v, want 5", got)
+    }
+}
+
+func TestPointRotate(t *testing.T) {
+    p := Point{X: 1, Y: 0}
+    got := p.Rotate(Unit(math.Pi / 2))
+    want := Point{X: 0, Y: 1}
+    if !almostEqual(got.X, want.X) || !almostEqual(got.Y, want.Y) {
+        t.Errorf("Rotate(pi/2) = %+v, want %+v", got, want)
+    }
+}
+
+func TestPolarRoundTrip(t *testing.T) {
+    p := Point{X: 3, Y: 4}
+    polar := FromCartesian(p)
+    if !almostEqual(polar.R, 5) {
+        t.Errorf("FromCartesian(%+v).R = %v, want 5", p, polar.R)
+    }
+    back := polar.ToCartesian()
+    if !almostEqual(back.X, p.X) || !almostEqual(back.Y, p.Y) {
+        t.Errorf("round trip = %+v, want %+v", back, p)
+    }
+}
+
+func TestPathLength(t *testing.T) {
+    path := Path{{X: 0, Y: 0}, {X: 3, Y: 4}, {X: 3, Y: 0}}
+    if got := path.Length(); !almostEqual(got, 9) {
+        t.Errorf("Length() = %v, want 9", got)
+    }
+}
+
+func TestPathBoundingBox(t *testing.T) {
+    path := Path{{X: -1, Y: 5}, {X: 3, Y: -2}, {X: 0, Y: 0}}
+    min, max := path.BoundingBox()
+    wantMin := Point{X: -1, Y: -2}
+    wantMax := Point{X: 3, Y: 5}
+    if min != wantMin || max != wantMax {
+        t.Errorf("BoundingBox() = %+v, %+v, want %+v, %+v", min, max, wantMin, wantMax)
+    }
+}
+
+func TestPathTranslate(t *testing.T) {
+    path := Path{{X: 1, Y: 1}, {X: 2, Y: 2}}
+    got := path.Translate(1, -1)
+    want := Path{{X: 2, Y: 0}, {X: 3, Y: 1}}
+    for i := range want {
+        if got[i] != want[i] {
+            t.Errorf("Translate()[%d] = %+v, want %+v", i, got[i], want[i])
+        }
+    }
+}